@@ -0,0 +1,72 @@
+package linq
+
+// Numeric constrains the selector result types Sum/Average can work
+// with, replacing the old int-only signatures so the library is usable
+// for money, timings, and scientific data alike.
+type Numeric interface {
+	~int | ~int8 | ~int16 | ~int32 | ~int64 |
+		~uint | ~uint8 | ~uint16 | ~uint32 | ~uint64 | ~uintptr |
+		~float32 | ~float64
+}
+
+// Ordered constrains the selector result types MinBy/MaxBy can compare
+// with <.
+type Ordered interface {
+	Numeric | ~string
+}
+
+// Sum calculates the sum over a selector, generic over any Numeric type.
+func Sum[T any, N Numeric](q Query[T], selector func(T) N) N {
+	var sum N
+	for _, item := range q.source {
+		sum += selector(item)
+	}
+	return sum
+}
+
+// Average calculates the mean of a selector over the query. It returns 0
+// for an empty query.
+func Average[T any, N Numeric](q Query[T], selector func(T) N) float64 {
+	if len(q.source) == 0 {
+		return 0
+	}
+	return float64(Sum(q, selector)) / float64(len(q.source))
+}
+
+// MinBy returns the source element whose selector key is smallest,
+// unlike the old Min which returned the projected key itself.
+func MinBy[T any, K Ordered](q Query[T], selector func(T) K) (T, bool) {
+	if len(q.source) == 0 {
+		var zero T
+		return zero, false
+	}
+	best := q.source[0]
+	bestKey := selector(best)
+	for _, item := range q.source[1:] {
+		k := selector(item)
+		if k < bestKey {
+			best = item
+			bestKey = k
+		}
+	}
+	return best, true
+}
+
+// MaxBy returns the source element whose selector key is largest,
+// unlike the old Max which returned the projected key itself.
+func MaxBy[T any, K Ordered](q Query[T], selector func(T) K) (T, bool) {
+	if len(q.source) == 0 {
+		var zero T
+		return zero, false
+	}
+	best := q.source[0]
+	bestKey := selector(best)
+	for _, item := range q.source[1:] {
+		k := selector(item)
+		if k > bestKey {
+			best = item
+			bestKey = k
+		}
+	}
+	return best, true
+}
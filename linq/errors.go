@@ -0,0 +1,81 @@
+package linq
+
+import "errors"
+
+// ErrElementNotFound is returned by Single/FirstOrError when no element
+// matches the predicate.
+var ErrElementNotFound = errors.New("linq: element not found")
+
+// ErrMultipleElements is returned by Single when more than one element
+// matches the predicate.
+var ErrMultipleElements = errors.New("linq: sequence contains more than one matching element")
+
+// Single returns the one element matching predicate, or an error if
+// zero or more than one element matches. Use errors.Is to check against
+// ErrElementNotFound/ErrMultipleElements.
+func (q Query[T]) Single(predicate func(T) bool) (T, error) {
+	var result T
+	found := false
+	for _, item := range q.source {
+		if !predicate(item) {
+			continue
+		}
+		if found {
+			var zero T
+			return zero, ErrMultipleElements
+		}
+		result = item
+		found = true
+	}
+	if !found {
+		var zero T
+		return zero, ErrElementNotFound
+	}
+	return result, nil
+}
+
+// SingleOrDefault returns the one element matching predicate, or def if
+// zero or more than one element matches. Callers that need to tell those
+// two cases apart should use Single directly and check the error with
+// errors.Is.
+func (q Query[T]) SingleOrDefault(predicate func(T) bool, def T) T {
+	result, err := q.Single(predicate)
+	if err != nil {
+		return def
+	}
+	return result
+}
+
+// FirstOrError returns the first element matching predicate, or
+// ErrElementNotFound if none matches.
+func (q Query[T]) FirstOrError(predicate func(T) bool) (T, error) {
+	item, ok := q.First(predicate)
+	if !ok {
+		var zero T
+		return zero, ErrElementNotFound
+	}
+	return item, nil
+}
+
+// Last returns the last element matching predicate.
+func (q Query[T]) Last(predicate func(T) bool) (T, bool) {
+	var result T
+	found := false
+	for _, item := range q.source {
+		if predicate(item) {
+			result = item
+			found = true
+		}
+	}
+	return result, found
+}
+
+// LastOrDefault returns the last element matching predicate, or def if
+// none matches.
+func (q Query[T]) LastOrDefault(predicate func(T) bool, def T) T {
+	result, ok := q.Last(predicate)
+	if !ok {
+		return def
+	}
+	return result
+}
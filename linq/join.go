@@ -0,0 +1,56 @@
+package linq
+
+// Join performs an inner hash-join: outer and inner are matched on
+// outerKey(o) == innerKey(i), and result is called once per matching
+// pair. Outer items with no match are dropped, mirroring SQL INNER JOIN.
+func Join[TOuter, TInner, TResult any, TKey comparable](outer Query[TOuter], inner []TInner, outerKey func(TOuter) TKey, innerKey func(TInner) TKey, result func(TOuter, TInner) TResult) Query[TResult] {
+	index := buildJoinIndex(inner, innerKey)
+	var out []TResult
+	for _, o := range outer.source {
+		for _, in := range index[outerKey(o)] {
+			out = append(out, result(o, in))
+		}
+	}
+	return Query[TResult]{source: out}
+}
+
+// LeftJoin is a hash-join like Join, except outer items with no match are
+// still emitted once, paired with the zero value of TInner, mirroring
+// SQL LEFT JOIN.
+func LeftJoin[TOuter, TInner, TResult any, TKey comparable](outer Query[TOuter], inner []TInner, outerKey func(TOuter) TKey, innerKey func(TInner) TKey, result func(TOuter, TInner) TResult) Query[TResult] {
+	index := buildJoinIndex(inner, innerKey)
+	var out []TResult
+	for _, o := range outer.source {
+		matches := index[outerKey(o)]
+		if len(matches) == 0 {
+			var zero TInner
+			out = append(out, result(o, zero))
+			continue
+		}
+		for _, in := range matches {
+			out = append(out, result(o, in))
+		}
+	}
+	return Query[TResult]{source: out}
+}
+
+// GroupJoin is a hash-join that emits one result per outer item, paired
+// with the full slice of its matches (which may be empty).
+func GroupJoin[TOuter, TInner, TResult any, TKey comparable](outer Query[TOuter], inner []TInner, outerKey func(TOuter) TKey, innerKey func(TInner) TKey, result func(TOuter, []TInner) TResult) Query[TResult] {
+	index := buildJoinIndex(inner, innerKey)
+	out := make([]TResult, 0, len(outer.source))
+	for _, o := range outer.source {
+		out = append(out, result(o, index[outerKey(o)]))
+	}
+	return Query[TResult]{source: out}
+}
+
+// buildJoinIndex groups inner by innerKey so Join/LeftJoin/GroupJoin can
+// look up matches in O(1) instead of scanning inner per outer item.
+func buildJoinIndex[TInner any, TKey comparable](inner []TInner, innerKey func(TInner) TKey) map[TKey][]TInner {
+	index := make(map[TKey][]TInner, len(inner))
+	for _, in := range inner {
+		index[innerKey(in)] = append(index[innerKey(in)], in)
+	}
+	return index
+}
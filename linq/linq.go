@@ -1,9 +1,5 @@
 package linq
 
-import (
-	"sort"
-)
-
 // Query is the main LINQ structure.
 type Query[T any] struct {
 	source []T
@@ -18,8 +14,8 @@ func From[T any](source []T) Query[T] {
 type LogicalOperator int
 
 const (
-	And LogicalOperator = iota
-	Or
+	LogicalAnd LogicalOperator = iota
+	LogicalOr
 )
 
 // PredicateGroup holds predicates and logical operator between them
@@ -28,37 +24,29 @@ type PredicateGroup[T any] struct {
 	LogicalOperator LogicalOperator
 }
 
-// Where applies multiple predicate functions with a logical operator.
+// WhereGroup applies multiple predicate functions with a logical operator.
+// It is a thin adapter over WhereExpr: the group is lowered into a
+// Predicate tree and evaluated there, so it keeps working unchanged
+// now that And/Or/Not can be nested via Predicate.
 func (q Query[T]) WhereGroup(group PredicateGroup[T]) Query[T] {
-	var result []T
-	for _, item := range q.source {
-		include := false
-		if group.LogicalOperator == And {
-			include = true
-			for _, pred := range group.Predicates {
-				if !pred(item) {
-					include = false
-					break
-				}
-			}
-		} else if group.LogicalOperator == Or {
-			for _, pred := range group.Predicates {
-				if pred(item) {
-					include = true
-					break
-				}
-			}
-		}
-		if include {
-			result = append(result, item)
-		}
+	return q.WhereExpr(group.lower())
+}
+
+// lower converts a flat PredicateGroup into an equivalent Predicate tree.
+func (g PredicateGroup[T]) lower() Predicate[T] {
+	leaves := make([]Predicate[T], len(g.Predicates))
+	for i, pred := range g.Predicates {
+		leaves[i] = Pred(pred)
 	}
-	return Query[T]{source: result}
+	if g.LogicalOperator == LogicalOr {
+		return Or(leaves...)
+	}
+	return And(leaves...)
 }
 
 // Where single predicate
 func (q Query[T]) Where(predicate func(T) bool) Query[T] {
-	return q.WhereGroup(PredicateGroup[T]{Predicates: []func(T) bool{predicate}, LogicalOperator: And})
+	return q.WhereExpr(Pred(predicate))
 }
 
 // ToSlice converts the query result to a slice.
@@ -120,60 +108,16 @@ func (q Query[T]) Distinct(equal func(a, b T) bool) Query[T] {
 	return Query[T]{source: result}
 }
 
-// OrderBy sorts items based on less function.
-func (q Query[T]) OrderBy(less func(a, b T) bool) Query[T] {
-	result := make([]T, len(q.source))
-	copy(result, q.source)
-	sort.Slice(result, func(i, j int) bool {
-		return less(result[i], result[j])
-	})
-	return Query[T]{source: result}
+// OrderBy sorts items based on less function, returning an OrderedQuery
+// so further ThenBy/ThenByDescending keys can be chained.
+func (q Query[T]) OrderBy(less func(a, b T) bool) OrderedQuery[T] {
+	return OrderedQuery[T]{source: q.source, keys: []orderKey[T]{{less: less}}}
 }
 
-// OrderByDescending sorts items in descending order.
-func (q Query[T]) OrderByDescending(less func(a, b T) bool) Query[T] {
-	return q.OrderBy(func(a, b T) bool {
-		return !less(a, b)
-	})
-}
-
-// Sum calculates the sum over a selector.
-func (q Query[T]) Sum(selector func(T) int) int {
-	sum := 0
-	for _, item := range q.source {
-		sum += selector(item)
-	}
-	return sum
-}
-
-// Min finds the minimum element based on selector.
-func (q Query[T]) Min(selector func(T) int) int {
-	if len(q.source) == 0 {
-		return 0
-	}
-	min := selector(q.source[0])
-	for _, item := range q.source[1:] {
-		v := selector(item)
-		if v < min {
-			min = v
-		}
-	}
-	return min
-}
-
-// Max finds the maximum element based on selector.
-func (q Query[T]) Max(selector func(T) int) int {
-	if len(q.source) == 0 {
-		return 0
-	}
-	max := selector(q.source[0])
-	for _, item := range q.source[1:] {
-		v := selector(item)
-		if v > max {
-			max = v
-		}
-	}
-	return max
+// OrderByDescending sorts items in descending order, returning an
+// OrderedQuery so further ThenBy/ThenByDescending keys can be chained.
+func (q Query[T]) OrderByDescending(less func(a, b T) bool) OrderedQuery[T] {
+	return OrderedQuery[T]{source: q.source, keys: []orderKey[T]{{less: less, descending: true}}}
 }
 
 // Skip skips the first n elements.
@@ -1,6 +1,9 @@
 package linq
 
 import (
+	"context"
+	"errors"
+	"sync/atomic"
 	"testing"
 )
 
@@ -38,7 +41,7 @@ func TestWhereAndWhereGroup(t *testing.T) {
 			func(n int) bool { return n > 2 },
 			func(n int) bool { return n < 5 },
 		},
-		LogicalOperator: And,
+		LogicalOperator: LogicalAnd,
 	}
 	result := q.WhereGroup(group).ToSlice()
 	expected := []int{3, 4}
@@ -57,7 +60,7 @@ func TestWhereAndWhereGroup(t *testing.T) {
 			func(n int) bool { return n == 1 },
 			func(n int) bool { return n == 5 },
 		},
-		LogicalOperator: Or,
+		LogicalOperator: LogicalOr,
 	}
 	resultOr := q.WhereGroup(groupOr).ToSlice()
 	expectedOr := []int{1, 5}
@@ -71,6 +74,26 @@ func TestWhereAndWhereGroup(t *testing.T) {
 	}
 }
 
+func TestWhereExpr(t *testing.T) {
+	data := []int{1, 2, 3, 4, 5, 6, 7, 8, 9, 10}
+	q := From(data)
+
+	isEven := Pred(func(n int) bool { return n%2 == 0 })
+	isSmall := Pred(func(n int) bool { return n < 5 })
+	isBig := Pred(func(n int) bool { return n > 8 })
+
+	result := q.WhereExpr(And(isEven, Or(isSmall, Not(isBig)))).ToSlice()
+	expected := []int{2, 4, 6, 8}
+	if len(result) != len(expected) {
+		t.Fatalf("WhereExpr length mismatch, got %v", result)
+	}
+	for i, v := range result {
+		if v != expected[i] {
+			t.Errorf("WhereExpr expected %d, got %d", expected[i], v)
+		}
+	}
+}
+
 func TestAnyAll(t *testing.T) {
 	data := []int{2, 4, 6}
 	q := From(data)
@@ -141,20 +164,54 @@ func TestOrderByAndOrderByDescending(t *testing.T) {
 	}
 }
 
-func TestSumMinMax(t *testing.T) {
-	data := []int{1, 3, 5, 7, 9}
+func TestThenByAndThenByDescending(t *testing.T) {
+	data := []testStruct{
+		{1, "b", 20},
+		{2, "a", 30},
+		{3, "a", 10},
+		{4, "b", 5},
+	}
+	q := From(data)
+	result := q.OrderBy(func(a, b testStruct) bool { return a.Name < b.Name }).
+		ThenByDescending(func(a, b testStruct) bool { return a.Value < b.Value }).
+		ToSlice()
+
+	expected := []int{2, 3, 1, 4} // by ID, reflecting name asc then value desc
+	for i, id := range expected {
+		if result[i].ID != id {
+			t.Errorf("ThenByDescending expected ID %d at position %d, got %d", id, i, result[i].ID)
+		}
+	}
+}
+
+func TestSumAverageMinByMaxBy(t *testing.T) {
+	data := []testStruct{
+		{1, "a", 1},
+		{2, "b", 3},
+		{3, "c", 5},
+		{4, "d", 7},
+		{5, "e", 9},
+	}
 	q := From(data)
-	sum := q.Sum(func(n int) int { return n })
+
+	sum := Sum(q, func(s testStruct) int { return s.Value })
 	if sum != 25 {
 		t.Errorf("Sum failed, expected 25 got %d", sum)
 	}
-	min := q.Min(func(n int) int { return n })
-	if min != 1 {
-		t.Errorf("Min failed, expected 1 got %d", min)
+
+	avg := Average(q, func(s testStruct) int { return s.Value })
+	if avg != 5 {
+		t.Errorf("Average failed, expected 5 got %v", avg)
+	}
+
+	min, ok := MinBy(q, func(s testStruct) int { return s.Value })
+	if !ok || min.ID != 1 {
+		t.Errorf("MinBy failed, expected element with ID 1, got %+v", min)
 	}
-	max := q.Max(func(n int) int { return n })
-	if max != 9 {
-		t.Errorf("Max failed, expected 9 got %d", max)
+
+	max, ok := MaxBy(q, func(s testStruct) int { return s.Value })
+	if !ok || max.ID != 5 {
+		t.Errorf("MaxBy failed, expected element with ID 5, got %+v", max)
 	}
 }
 
@@ -206,6 +263,114 @@ func TestAggregate(t *testing.T) {
 	}
 }
 
+func TestParallelSelectWhereAggregate(t *testing.T) {
+	data := make([]int, 100)
+	for i := range data {
+		data[i] = i + 1
+	}
+	q := From(data)
+
+	squared := PSelect(q, func(n int) int { return n * n }, 4).ToSlice()
+	for i, v := range squared {
+		if v != data[i]*data[i] {
+			t.Fatalf("PSelect order mismatch at %d, got %d", i, v)
+		}
+	}
+
+	even := q.PWhere(func(n int) bool { return n%2 == 0 }, 4).ToSlice()
+	if len(even) != 50 || even[0] != 2 {
+		t.Errorf("PWhere failed, got %v", even[:1])
+	}
+
+	sum := PAggregate(q, 0, func(acc, n int) int { return acc + n }, func(a, b int) int { return a + b }, 4)
+	if sum != 5050 {
+		t.Errorf("PAggregate failed, expected 5050 got %d", sum)
+	}
+}
+
+func TestPAggregateNonZeroSeedIsAppliedOnce(t *testing.T) {
+	data := []int{1, 2, 3, 4}
+	q := From(data)
+	add := func(acc, n int) int { return acc + n }
+
+	sequential := q.Aggregate(100, add)
+
+	for _, concurrency := range []int{1, 2, 4} {
+		got := PAggregate(q, 100, add, add, concurrency)
+		if got != sequential {
+			t.Errorf("PAggregate with concurrency %d should match sequential Aggregate (%d), got %d", concurrency, sequential, got)
+		}
+	}
+}
+
+func TestPSelectCtxCancellation(t *testing.T) {
+	data := make([]int, 20)
+	for i := range data {
+		data[i] = i + 1
+	}
+	q := From(data)
+
+	// Pre-cancelled context: no work should be fed at all.
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	results := PSelectCtx(ctx, q, func(n int) int { return n * n }, 4).ToSlice()
+	for i, v := range results {
+		if v != 0 {
+			t.Errorf("PSelectCtx with pre-cancelled context should not process index %d, got %d", i, v)
+		}
+	}
+
+	// Cancelled partway through: with a single worker, processing is
+	// sequential, so cancelling after the 3rd item deterministically
+	// stops the feed before any later item is sent.
+	ctx2, cancel2 := context.WithCancel(context.Background())
+	var processed int32
+	results2 := PSelectCtx(ctx2, q, func(n int) int {
+		if atomic.AddInt32(&processed, 1) == 3 {
+			cancel2()
+		}
+		return n * n
+	}, 1).ToSlice()
+	if processed != 3 {
+		t.Errorf("PSelectCtx should stop processing once cancelled, processed %d items", processed)
+	}
+	for i := 3; i < len(results2); i++ {
+		if results2[i] != 0 {
+			t.Errorf("PSelectCtx should leave index %d unprocessed after cancellation, got %d", i, results2[i])
+		}
+	}
+}
+
+func TestPWhereCtxCancellation(t *testing.T) {
+	data := make([]int, 20)
+	for i := range data {
+		data[i] = i + 1
+	}
+	q := From(data)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	result := q.PWhereCtx(ctx, func(n int) bool { return true }, 4).ToSlice()
+	if len(result) != 0 {
+		t.Errorf("PWhereCtx with pre-cancelled context should match nothing, got %v", result)
+	}
+
+	ctx2, cancel2 := context.WithCancel(context.Background())
+	var processed int32
+	result2 := q.PWhereCtx(ctx2, func(n int) bool {
+		if atomic.AddInt32(&processed, 1) == 3 {
+			cancel2()
+		}
+		return true
+	}, 1).ToSlice()
+	if processed != 3 {
+		t.Errorf("PWhereCtx should stop processing once cancelled, processed %d items", processed)
+	}
+	if len(result2) != 3 {
+		t.Errorf("PWhereCtx should only keep matches seen before cancellation, got %v", result2)
+	}
+}
+
 func TestUnionIntersectExcept(t *testing.T) {
 	a := []int{1, 2, 3, 4}
 	b := []int{3, 4, 5, 6}
@@ -231,6 +396,34 @@ func TestUnionIntersectExcept(t *testing.T) {
 	}
 }
 
+func TestDistinctUnionIntersectExceptBy(t *testing.T) {
+	a := []int{1, 2, 3, 4}
+	b := []int{3, 4, 5, 6}
+	identity := func(n int) int { return n }
+
+	distinct := DistinctBy(From([]int{1, 1, 2, 3, 3, 3}), identity).ToSlice()
+	if len(distinct) != 3 {
+		t.Errorf("DistinctBy failed, expected 3 got %d", len(distinct))
+	}
+
+	union := UnionBy(From(a), b, identity).ToSlice()
+	if len(union) != 6 {
+		t.Errorf("UnionBy length mismatch, got %v", union)
+	}
+
+	intersect := IntersectBy(From(a), b, identity).ToSlice()
+	expectedIntersect := []int{3, 4}
+	if len(intersect) != len(expectedIntersect) {
+		t.Errorf("IntersectBy length mismatch, got %v", intersect)
+	}
+
+	except := ExceptBy(From(a), b, identity).ToSlice()
+	expectedExcept := []int{1, 2}
+	if len(except) != len(expectedExcept) {
+		t.Errorf("ExceptBy length mismatch, got %v", except)
+	}
+}
+
 func TestSelectGroupByToMap(t *testing.T) {
 	data := []testStruct{
 		{1, "a", 10},
@@ -263,3 +456,139 @@ func TestSelectGroupByToMap(t *testing.T) {
 		t.Errorf("ToMap values incorrect")
 	}
 }
+
+func TestStreamLaziness(t *testing.T) {
+	// FromRange(0, 1<<30, 1) is far too long to drain; Take must stop pulling after 5.
+	infinite := FromRange(0, 1<<30, 1)
+	taken := infinite.Where(func(n int) bool { return n%2 == 0 }).Take(5).ToSlice()
+	expected := []int{0, 2, 4, 6, 8}
+	if len(taken) != len(expected) {
+		t.Fatalf("Stream Take length mismatch, got %v", taken)
+	}
+	for i, v := range taken {
+		if v != expected[i] {
+			t.Errorf("Stream Take expected %d, got %d", expected[i], v)
+		}
+	}
+
+	pulls := 0
+	source := FromFunc(func() (int, bool) {
+		pulls++
+		return pulls, true
+	})
+	first, ok := source.First(func(n int) bool { return n == 3 })
+	if !ok || first != 3 {
+		t.Errorf("Stream First failed, got %d, ok=%v", first, ok)
+	}
+	if pulls != 3 {
+		t.Errorf("Stream First should stop at the first match, pulled %d times", pulls)
+	}
+
+	squared := SelectStream(FromRange(1, 4, 1), func(n int) int { return n * n }).ToSlice()
+	expectedSquared := []int{1, 4, 9}
+	for i, v := range squared {
+		if v != expectedSquared[i] {
+			t.Errorf("SelectStream expected %d, got %d", expectedSquared[i], v)
+		}
+	}
+}
+
+type order struct {
+	ID         int
+	CustomerID int
+	Total      int
+}
+
+func TestJoinLeftJoinGroupJoin(t *testing.T) {
+	customers := From([]testStruct{{1, "Alice", 0}, {2, "Bob", 0}, {3, "Carol", 0}})
+	orders := []order{{100, 1, 10}, {101, 1, 20}, {102, 2, 30}}
+
+	type pair struct {
+		Customer string
+		OrderID  int
+	}
+
+	joined := Join(customers, orders,
+		func(c testStruct) int { return c.ID },
+		func(o order) int { return o.CustomerID },
+		func(c testStruct, o order) pair { return pair{c.Name, o.ID} },
+	).ToSlice()
+	if len(joined) != 3 {
+		t.Fatalf("Join length mismatch, got %v", joined)
+	}
+
+	left := LeftJoin(customers, orders,
+		func(c testStruct) int { return c.ID },
+		func(o order) int { return o.CustomerID },
+		func(c testStruct, o order) pair { return pair{c.Name, o.ID} },
+	).ToSlice()
+	if len(left) != 4 {
+		t.Fatalf("LeftJoin length mismatch, got %v", left)
+	}
+	if left[3].Customer != "Carol" || left[3].OrderID != 0 {
+		t.Errorf("LeftJoin unmatched customer failed, got %+v", left[3])
+	}
+
+	type grouped struct {
+		Customer string
+		Orders   []order
+	}
+	groups := GroupJoin(customers, orders,
+		func(c testStruct) int { return c.ID },
+		func(o order) int { return o.CustomerID },
+		func(c testStruct, os []order) grouped { return grouped{c.Name, os} },
+	).ToSlice()
+	if len(groups) != 3 || len(groups[0].Orders) != 2 || len(groups[2].Orders) != 0 {
+		t.Errorf("GroupJoin grouping failed, got %+v", groups)
+	}
+}
+
+func TestSingleLastFirstOrError(t *testing.T) {
+	data := []int{1, 2, 3, 4, 5}
+	q := From(data)
+
+	single, err := q.Single(func(n int) bool { return n == 3 })
+	if err != nil || single != 3 {
+		t.Errorf("Single failed, got %d, err=%v", single, err)
+	}
+
+	_, err = q.Single(func(n int) bool { return n > 10 })
+	if !errors.Is(err, ErrElementNotFound) {
+		t.Errorf("Single should return ErrElementNotFound, got %v", err)
+	}
+
+	_, err = q.Single(func(n int) bool { return n%2 == 1 })
+	if !errors.Is(err, ErrMultipleElements) {
+		t.Errorf("Single should return ErrMultipleElements, got %v", err)
+	}
+
+	defaulted := q.SingleOrDefault(func(n int) bool { return n > 10 }, -1)
+	if defaulted != -1 {
+		t.Errorf("SingleOrDefault failed, got %d", defaulted)
+	}
+
+	multiDefaulted := q.SingleOrDefault(func(n int) bool { return n%2 == 1 }, -1)
+	if multiDefaulted != -1 {
+		t.Errorf("SingleOrDefault on multiple matches failed, got %d", multiDefaulted)
+	}
+
+	last, ok := q.Last(func(n int) bool { return n%2 == 1 })
+	if !ok || last != 5 {
+		t.Errorf("Last failed, got %d, ok=%v", last, ok)
+	}
+
+	lastDefaulted := q.LastOrDefault(func(n int) bool { return n > 10 }, -1)
+	if lastDefaulted != -1 {
+		t.Errorf("LastOrDefault failed, got %d", lastDefaulted)
+	}
+
+	first, err := q.FirstOrError(func(n int) bool { return n > 3 })
+	if err != nil || first != 4 {
+		t.Errorf("FirstOrError failed, got %d, err=%v", first, err)
+	}
+
+	_, err = q.FirstOrError(func(n int) bool { return n > 10 })
+	if !errors.Is(err, ErrElementNotFound) {
+		t.Errorf("FirstOrError should return ErrElementNotFound, got %v", err)
+	}
+}
@@ -0,0 +1,62 @@
+package linq
+
+import "sort"
+
+// orderKey is one ORDER BY key: a comparator and its direction.
+type orderKey[T any] struct {
+	less       func(a, b T) bool
+	descending bool
+}
+
+// OrderedQuery is a Query with one or more ordering keys applied. It is
+// produced by OrderBy/OrderByDescending and extended with
+// ThenBy/ThenByDescending, mirroring SQL's `ORDER BY a ASC, b DESC`.
+// The keys are only materialized into a single stable sort when the
+// result is read, so chaining ThenBy never throws away prior keys.
+type OrderedQuery[T any] struct {
+	source []T
+	keys   []orderKey[T]
+}
+
+// ThenBy adds a subordinate ascending key, used to break ties left by
+// the preceding keys.
+func (oq OrderedQuery[T]) ThenBy(less func(a, b T) bool) OrderedQuery[T] {
+	keys := append(append([]orderKey[T]{}, oq.keys...), orderKey[T]{less: less})
+	return OrderedQuery[T]{source: oq.source, keys: keys}
+}
+
+// ThenByDescending adds a subordinate descending key, used to break ties
+// left by the preceding keys.
+func (oq OrderedQuery[T]) ThenByDescending(less func(a, b T) bool) OrderedQuery[T] {
+	keys := append(append([]orderKey[T]{}, oq.keys...), orderKey[T]{less: less, descending: true})
+	return OrderedQuery[T]{source: oq.source, keys: keys}
+}
+
+// ToSlice performs the single stable sort comparing keys in order until
+// one differentiates, then returns the result.
+func (oq OrderedQuery[T]) ToSlice() []T {
+	result := make([]T, len(oq.source))
+	copy(result, oq.source)
+	sort.SliceStable(result, func(i, j int) bool {
+		a, b := result[i], result[j]
+		for _, k := range oq.keys {
+			aLess := k.less(a, b)
+			bLess := k.less(b, a)
+			if !aLess && !bLess {
+				continue // tied on this key, fall through to the next one
+			}
+			if k.descending {
+				return bLess
+			}
+			return aLess
+		}
+		return false
+	})
+	return result
+}
+
+// Query converts the ordered result back into a plain Query[T] for
+// further chaining (Where, Select, and so on).
+func (oq OrderedQuery[T]) Query() Query[T] {
+	return Query[T]{source: oq.ToSlice()}
+}
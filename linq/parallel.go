@@ -0,0 +1,173 @@
+package linq
+
+import (
+	"context"
+	"sync"
+)
+
+// indexed pairs a source item with its position so parallel workers can
+// write results back in the original order.
+type indexed[T any] struct {
+	index int
+	item  T
+}
+
+// PSelect projects each element into a new form using concurrency worker
+// goroutines, preserving input order in the result. See PSelectCtx for a
+// cancellable variant.
+func PSelect[T, R any](q Query[T], selector func(T) R, concurrency int) Query[R] {
+	return PSelectCtx(context.Background(), q, selector, concurrency)
+}
+
+// PSelectCtx is PSelect with a context: remaining work is abandoned once
+// ctx is done, leaving the corresponding result slots zero-valued.
+func PSelectCtx[T, R any](ctx context.Context, q Query[T], selector func(T) R, concurrency int) Query[R] {
+	results := make([]R, len(q.source))
+	if len(q.source) == 0 {
+		return Query[R]{source: results}
+	}
+	if concurrency < 1 {
+		concurrency = 1
+	}
+
+	jobs := make(chan indexed[T])
+	var wg sync.WaitGroup
+	for w := 0; w < concurrency; w++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for job := range jobs {
+				if ctx.Err() != nil {
+					continue
+				}
+				results[job.index] = selector(job.item)
+			}
+		}()
+	}
+
+feed:
+	for i, item := range q.source {
+		select {
+		case <-ctx.Done():
+			break feed
+		default:
+		}
+		select {
+		case <-ctx.Done():
+			break feed
+		case jobs <- indexed[T]{index: i, item: item}:
+		}
+	}
+	close(jobs)
+	wg.Wait()
+
+	return Query[R]{source: results}
+}
+
+// PWhere filters the query using concurrency worker goroutines, preserving
+// input order in the result. See PWhereCtx for a cancellable variant.
+func (q Query[T]) PWhere(pred func(T) bool, concurrency int) Query[T] {
+	return q.PWhereCtx(context.Background(), pred, concurrency)
+}
+
+// PWhereCtx is PWhere with a context: remaining work is abandoned once ctx
+// is done, so items not yet evaluated are excluded from the result.
+func (q Query[T]) PWhereCtx(ctx context.Context, pred func(T) bool, concurrency int) Query[T] {
+	keep := make([]bool, len(q.source))
+	if len(q.source) == 0 {
+		return Query[T]{}
+	}
+	if concurrency < 1 {
+		concurrency = 1
+	}
+
+	jobs := make(chan indexed[T])
+	var wg sync.WaitGroup
+	for w := 0; w < concurrency; w++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for job := range jobs {
+				if ctx.Err() != nil {
+					continue
+				}
+				keep[job.index] = pred(job.item)
+			}
+		}()
+	}
+
+feed:
+	for i, item := range q.source {
+		select {
+		case <-ctx.Done():
+			break feed
+		default:
+		}
+		select {
+		case <-ctx.Done():
+			break feed
+		case jobs <- indexed[T]{index: i, item: item}:
+		}
+	}
+	close(jobs)
+	wg.Wait()
+
+	var result []T
+	for i, k := range keep {
+		if k {
+			result = append(result, q.source[i])
+		}
+	}
+	return Query[T]{source: result}
+}
+
+// PAggregate combines elements using concurrency worker goroutines. Items
+// are dealt to workers round-robin; each worker folds its share into a
+// local accumulator with combiner, then reducer merges the partial
+// accumulators in worker order into the final result, in the style of
+// samber/lo's parallel helpers. seed is folded in exactly once (into the
+// first worker's accumulator); the other workers start from the zero
+// value of A, so the result matches a sequential Aggregate only when that
+// zero value is neutral for combiner and item order does not matter, as
+// with addition.
+func PAggregate[T, A any](q Query[T], seed A, combiner func(A, T) A, reducer func(A, A) A, concurrency int) A {
+	if len(q.source) == 0 {
+		return seed
+	}
+	if concurrency < 1 {
+		concurrency = 1
+	}
+	if concurrency > len(q.source) {
+		concurrency = len(q.source)
+	}
+
+	chunks := make([][]T, concurrency)
+	for i, item := range q.source {
+		w := i % concurrency
+		chunks[w] = append(chunks[w], item)
+	}
+
+	partials := make([]A, concurrency)
+	var wg sync.WaitGroup
+	for w, chunk := range chunks {
+		wg.Add(1)
+		go func(w int, chunk []T) {
+			defer wg.Done()
+			var acc A
+			if w == 0 {
+				acc = seed
+			}
+			for _, item := range chunk {
+				acc = combiner(acc, item)
+			}
+			partials[w] = acc
+		}(w, chunk)
+	}
+	wg.Wait()
+
+	result := partials[0]
+	for _, p := range partials[1:] {
+		result = reducer(result, p)
+	}
+	return result
+}
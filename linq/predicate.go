@@ -0,0 +1,80 @@
+package linq
+
+// ExpOp identifies the kind of node in a Predicate expression tree.
+type ExpOp int
+
+const (
+	OpLeaf ExpOp = iota
+	OpAnd
+	OpOr
+	OpNot
+)
+
+// Predicate is a boolean expression tree over T. Leaves wrap a plain
+// predicate function; And/Or/Not nodes combine child predicates with
+// short-circuiting SQL-style boolean evaluation. Build trees with the
+// Pred/And/Or/Not helpers rather than constructing Predicate directly.
+type Predicate[T any] struct {
+	Op       ExpOp
+	Children []Predicate[T]
+	Fn       func(T) bool
+}
+
+// Pred wraps a plain predicate function as a leaf node.
+func Pred[T any](fn func(T) bool) Predicate[T] {
+	return Predicate[T]{Op: OpLeaf, Fn: fn}
+}
+
+// And combines predicates so all of them must hold.
+func And[T any](children ...Predicate[T]) Predicate[T] {
+	return Predicate[T]{Op: OpAnd, Children: children}
+}
+
+// Or combines predicates so at least one of them must hold.
+func Or[T any](children ...Predicate[T]) Predicate[T] {
+	return Predicate[T]{Op: OpOr, Children: children}
+}
+
+// Not negates a predicate.
+func Not[T any](child Predicate[T]) Predicate[T] {
+	return Predicate[T]{Op: OpNot, Children: []Predicate[T]{child}}
+}
+
+// eval walks the tree for a single item, short-circuiting like SQL
+// boolean evaluation.
+func (p Predicate[T]) eval(item T) bool {
+	switch p.Op {
+	case OpLeaf:
+		return p.Fn(item)
+	case OpAnd:
+		for _, child := range p.Children {
+			if !child.eval(item) {
+				return false
+			}
+		}
+		return true
+	case OpOr:
+		for _, child := range p.Children {
+			if child.eval(item) {
+				return true
+			}
+		}
+		return false
+	case OpNot:
+		return !p.Children[0].eval(item)
+	default:
+		return false
+	}
+}
+
+// WhereExpr filters the query using a Predicate expression tree, e.g.
+// And(Pred(isFemale), Or(Pred(isTeen), Not(Pred(isMarried)))).
+func (q Query[T]) WhereExpr(expr Predicate[T]) Query[T] {
+	var result []T
+	for _, item := range q.source {
+		if expr.eval(item) {
+			result = append(result, item)
+		}
+	}
+	return Query[T]{source: result}
+}
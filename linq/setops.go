@@ -0,0 +1,61 @@
+package linq
+
+// DistinctBy, UnionBy, IntersectBy, and ExceptBy are O(n) alternatives to
+// Distinct/Union/Intersect/Except for element types with a comparable
+// key. The equality-func versions remain for types that can't supply
+// one (e.g. comparing by a tolerance or a slice field); prefer the *By
+// variants whenever a comparable key is available; the equal-func
+// versions scan O(n^2) and become unusable past a few thousand items.
+
+// DistinctBy returns distinct elements using a map[K]struct{} seen-set
+// keyed by key, preserving first-occurrence order.
+func DistinctBy[T any, K comparable](q Query[T], key func(T) K) Query[T] {
+	seen := make(map[K]struct{}, len(q.source))
+	var result []T
+	for _, item := range q.source {
+		k := key(item)
+		if _, ok := seen[k]; ok {
+			continue
+		}
+		seen[k] = struct{}{}
+		result = append(result, item)
+	}
+	return Query[T]{source: result}
+}
+
+// UnionBy returns the distinct union of q and other, keyed by key.
+func UnionBy[T any, K comparable](q Query[T], other []T, key func(T) K) Query[T] {
+	combined := append(append([]T{}, q.source...), other...)
+	return DistinctBy(From(combined), key)
+}
+
+// IntersectBy returns the distinct elements of q whose key also appears
+// in other.
+func IntersectBy[T any, K comparable](q Query[T], other []T, key func(T) K) Query[T] {
+	otherKeys := make(map[K]struct{}, len(other))
+	for _, o := range other {
+		otherKeys[key(o)] = struct{}{}
+	}
+	var result []T
+	for _, item := range q.source {
+		if _, ok := otherKeys[key(item)]; ok {
+			result = append(result, item)
+		}
+	}
+	return DistinctBy(From(result), key)
+}
+
+// ExceptBy returns the elements of q whose key does not appear in other.
+func ExceptBy[T any, K comparable](q Query[T], other []T, key func(T) K) Query[T] {
+	otherKeys := make(map[K]struct{}, len(other))
+	for _, o := range other {
+		otherKeys[key(o)] = struct{}{}
+	}
+	var result []T
+	for _, item := range q.source {
+		if _, ok := otherKeys[key(item)]; !ok {
+			result = append(result, item)
+		}
+	}
+	return Query[T]{source: result}
+}
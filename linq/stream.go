@@ -0,0 +1,164 @@
+package linq
+
+import "context"
+
+// Stream is a lazily-pulled sequence of T. Unlike Query, which holds a
+// fully materialized slice, Stream pulls one item at a time through next,
+// so Where/Select/Take/Skip/First compose without buffering the whole
+// sequence in memory. This suits pipelines over sources that don't fit
+// in memory, such as channels or DB cursors.
+type Stream[T any] struct {
+	next func() (T, bool)
+}
+
+// FromChan builds a Stream that pulls from ch until it is closed.
+func FromChan[T any](ch <-chan T) Stream[T] {
+	return Stream[T]{next: func() (T, bool) {
+		v, ok := <-ch
+		return v, ok
+	}}
+}
+
+// FromFunc builds a Stream directly from a pull function.
+func FromFunc[T any](next func() (T, bool)) Stream[T] {
+	return Stream[T]{next: next}
+}
+
+// FromRange builds a Stream of ints from start toward end, exclusive,
+// advancing by step. A step of 0, or one that points away from end,
+// yields an empty Stream.
+func FromRange(start, end, step int) Stream[int] {
+	current := start
+	return Stream[int]{next: func() (int, bool) {
+		if step == 0 || (step > 0 && current >= end) || (step < 0 && current <= end) {
+			return 0, false
+		}
+		v := current
+		current += step
+		return v, true
+	}}
+}
+
+// Where returns a Stream that only yields items matching pred, pulling
+// from the source lazily.
+func (s Stream[T]) Where(pred func(T) bool) Stream[T] {
+	return Stream[T]{next: func() (T, bool) {
+		for {
+			v, ok := s.next()
+			if !ok {
+				var zero T
+				return zero, false
+			}
+			if pred(v) {
+				return v, true
+			}
+		}
+	}}
+}
+
+// Take returns a Stream that stops pulling after n items, even if the
+// source is infinite.
+func (s Stream[T]) Take(n int) Stream[T] {
+	taken := 0
+	return Stream[T]{next: func() (T, bool) {
+		if taken >= n {
+			var zero T
+			return zero, false
+		}
+		v, ok := s.next()
+		if !ok {
+			var zero T
+			return zero, false
+		}
+		taken++
+		return v, true
+	}}
+}
+
+// Skip returns a Stream that discards the first n items, pulling them
+// from the source once on the first call.
+func (s Stream[T]) Skip(n int) Stream[T] {
+	skipped := false
+	return Stream[T]{next: func() (T, bool) {
+		if !skipped {
+			skipped = true
+			for i := 0; i < n; i++ {
+				if _, ok := s.next(); !ok {
+					break
+				}
+			}
+		}
+		return s.next()
+	}}
+}
+
+// First pulls items until pred matches, stopping immediately rather than
+// draining the rest of the source.
+func (s Stream[T]) First(pred func(T) bool) (T, bool) {
+	for {
+		v, ok := s.next()
+		if !ok {
+			var zero T
+			return zero, false
+		}
+		if pred(v) {
+			return v, true
+		}
+	}
+}
+
+// ToSlice drains the Stream into a slice.
+func (s Stream[T]) ToSlice() []T {
+	var result []T
+	for {
+		v, ok := s.next()
+		if !ok {
+			return result
+		}
+		result = append(result, v)
+	}
+}
+
+// ToChan drains the Stream into a channel on a background goroutine,
+// stopping early if ctx is done.
+func (s Stream[T]) ToChan(ctx context.Context) <-chan T {
+	out := make(chan T)
+	go func() {
+		defer close(out)
+		for {
+			v, ok := s.next()
+			if !ok {
+				return
+			}
+			select {
+			case out <- v:
+			case <-ctx.Done():
+				return
+			}
+		}
+	}()
+	return out
+}
+
+// ForEach drains the Stream, calling fn with each item.
+func (s Stream[T]) ForEach(fn func(T)) {
+	for {
+		v, ok := s.next()
+		if !ok {
+			return
+		}
+		fn(v)
+	}
+}
+
+// SelectStream projects each item of a Stream into a new form lazily.
+func SelectStream[T, R any](s Stream[T], selector func(T) R) Stream[R] {
+	return Stream[R]{next: func() (R, bool) {
+		v, ok := s.next()
+		if !ok {
+			var zero R
+			return zero, false
+		}
+		return selector(v), true
+	}}
+}
@@ -32,7 +32,7 @@ func main() {
 			func(p Person) bool { return p.Age > 30 },
 			func(p Person) bool { return p.Gender == "Female" },
 		},
-		LogicalOperator: linq.And,
+		LogicalOperator: linq.LogicalAnd,
 	})
 
 	fmt.Println("30 yaşından büyük kadınlar:")
@@ -49,8 +49,8 @@ func main() {
 	}
 
 	// 3. Max yaş
-	maxAge := query.Max(func(p Person) int { return p.Age })
-	fmt.Println("\nEn büyük yaş:", maxAge)
+	oldest, _ := linq.MaxBy(query, func(p Person) int { return p.Age })
+	fmt.Println("\nEn büyük yaş:", oldest.Age)
 
 	// 4. GroupBy cinsiyete göre gruplama
 	grouped := linq.GroupBy(query, func(p Person) string { return p.Gender })